@@ -0,0 +1,156 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// BlockStorage volume on Vultr account
+type BlockStorage struct {
+	ID           string `json:"SUBID"`
+	Name         string `json:"label"`
+	SizeGB       int    `json:"size_gb"`
+	RegionID     int    `json:"DCID"`
+	AttachedToID string `json:"attached_to_SUBID"`
+	Cost         string `json:"cost_per_month"`
+	Status       string `json:"status"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler on BlockStorage. SizeGB and
+// RegionID can arrive as either a JSON number or a JSON string, so they're
+// decoded through jsonNumber; SUBID, the attached server ID, cost and
+// status are documented as strings but, like every other field in this API,
+// aren't guaranteed to stay that way, so they go through jsonString too.
+func (b *BlockStorage) UnmarshalJSON(data []byte) error {
+	type alias BlockStorage
+	aux := &struct {
+		ID           jsonString `json:"SUBID"`
+		SizeGB       jsonNumber `json:"size_gb"`
+		RegionID     jsonNumber `json:"DCID"`
+		AttachedToID jsonString `json:"attached_to_SUBID"`
+		Cost         jsonString `json:"cost_per_month"`
+		Status       jsonString `json:"status"`
+		*alias
+	}{
+		alias: (*alias)(b),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	b.ID = string(aux.ID)
+	b.SizeGB = int(aux.SizeGB)
+	b.RegionID = int(aux.RegionID)
+	b.AttachedToID = string(aux.AttachedToID)
+	b.Cost = string(aux.Cost)
+	b.Status = string(aux.Status)
+
+	return nil
+}
+
+// CreateBlockStorage creates a new block storage volume
+func (c *Client) CreateBlockStorage(name string, regionID, sizeGB int) (BlockStorage, error) {
+	values := url.Values{
+		"label":   {name},
+		"DCID":    {fmt.Sprintf("%v", regionID)},
+		"size_gb": {fmt.Sprintf("%v", sizeGB)},
+	}
+
+	var storage BlockStorage
+	if err := c.post(`block/create`, values, &storage); err != nil {
+		return BlockStorage{}, err
+	}
+	storage.Name = name
+	storage.RegionID = regionID
+	storage.SizeGB = sizeGB
+
+	return storage, nil
+}
+
+// ListBlockStorage lists all block storage volumes on the current account
+func (c *Client) ListBlockStorage() (storages []BlockStorage, err error) {
+	if err := c.get(`block/list`, &storages); err != nil {
+		return nil, err
+	}
+	return storages, nil
+}
+
+// GetBlockStorage looks up a single block storage volume by ID
+func (c *Client) GetBlockStorage(id string) (BlockStorage, error) {
+	storages, err := c.ListBlockStorage()
+	if err != nil {
+		return BlockStorage{}, err
+	}
+
+	for _, storage := range storages {
+		if storage.ID == id {
+			return storage, nil
+		}
+	}
+	return BlockStorage{}, fmt.Errorf("block storage volume %v not found", id)
+}
+
+// ResizeBlockStorage changes the size of a block storage volume
+func (c *Client) ResizeBlockStorage(id string, sizeGB int) error {
+	values := url.Values{
+		"SUBID":   {id},
+		"size_gb": {fmt.Sprintf("%v", sizeGB)},
+	}
+
+	if err := c.post(`block/resize`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// LabelBlockStorage renames a block storage volume
+func (c *Client) LabelBlockStorage(id, name string) error {
+	values := url.Values{
+		"SUBID": {id},
+		"label": {name},
+	}
+
+	if err := c.post(`block/label_set`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AttachBlockStorage attaches a block storage volume to a server
+func (c *Client) AttachBlockStorage(storageID, serverID string) error {
+	values := url.Values{
+		"SUBID":           {storageID},
+		"attach_to_SUBID": {serverID},
+	}
+
+	if err := c.post(`block/attach`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DetachBlockStorage detaches a block storage volume from its server
+func (c *Client) DetachBlockStorage(storageID string) error {
+	values := url.Values{
+		"SUBID": {storageID},
+	}
+
+	if err := c.post(`block/detach`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteBlockStorage deletes a block storage volume
+func (c *Client) DeleteBlockStorage(id string) error {
+	values := url.Values{
+		"SUBID": {id},
+	}
+
+	if err := c.post(`block/delete`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
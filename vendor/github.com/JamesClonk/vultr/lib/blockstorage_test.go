@@ -0,0 +1,28 @@
+package lib
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBlockStorageUnmarshalJSONHandlesNumericSUBID(t *testing.T) {
+	data := []byte(`{"SUBID": 5678, "label": "data", "size_gb": "50", "DCID": 1, "attached_to_SUBID": 1234, "cost_per_month": 5, "status": "active"}`)
+
+	var b BlockStorage
+	if err := json.Unmarshal(data, &b); err != nil {
+		t.Fatalf("unexpected error decoding block storage with numeric SUBID: %v", err)
+	}
+
+	if b.ID != "5678" {
+		t.Errorf("ID = %q, want %q", b.ID, "5678")
+	}
+	if b.AttachedToID != "1234" {
+		t.Errorf("AttachedToID = %q, want %q", b.AttachedToID, "1234")
+	}
+	if b.Cost != "5" {
+		t.Errorf("Cost = %q, want %q", b.Cost, "5")
+	}
+	if b.SizeGB != 50 {
+		t.Errorf("SizeGB = %v, want 50", b.SizeGB)
+	}
+}
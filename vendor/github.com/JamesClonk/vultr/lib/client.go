@@ -0,0 +1,171 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Version of this library
+	Version = "1.0.0"
+
+	defaultEndpoint = "https://api.vultr.com/"
+	defaultRate     = 600 * time.Millisecond
+	defaultBurst    = 2
+	defaultRetries  = 3
+)
+
+// Client talks to the Vultr v1 API
+type Client struct {
+	APIKey     string
+	Endpoint   *url.URL
+	UserAgent  string
+	HTTPClient *http.Client
+
+	limiter    *rateLimiter
+	maxRetries int
+}
+
+// Options for configuring a new Client
+type Options struct {
+	Endpoint   string
+	UserAgent  string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Vultr API client authenticated with apiKey
+func NewClient(apiKey string, options *Options) *Client {
+	if options == nil {
+		options = &Options{}
+	}
+
+	endpoint, _ := url.Parse(defaultEndpoint)
+	if options.Endpoint != "" {
+		endpoint, _ = url.Parse(options.Endpoint)
+	}
+
+	httpClient := options.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	userAgent := options.UserAgent
+	if userAgent == "" {
+		userAgent = "vultr-go/" + Version
+	}
+
+	return &Client{
+		APIKey:     apiKey,
+		Endpoint:   endpoint,
+		UserAgent:  userAgent,
+		HTTPClient: httpClient,
+		limiter:    newRateLimiter(defaultRate, defaultBurst),
+		maxRetries: defaultRetries,
+	}
+}
+
+// SetRateLimit configures how often requests may be sent to the Vultr API.
+// rate is the minimum interval between requests and burst is the number of
+// requests allowed to fire immediately before that interval is enforced.
+func (c *Client) SetRateLimit(rate time.Duration, burst int) {
+	c.limiter = newRateLimiter(rate, burst)
+}
+
+// SetMaxRetries configures how many times a request is retried after a rate
+// limit response from the Vultr API. A value of 0 disables retries.
+func (c *Client) SetMaxRetries(n int) {
+	c.maxRetries = n
+}
+
+func (c *Client) get(path string, data interface{}) error {
+	return c.do("GET", path, nil, data)
+}
+
+func (c *Client) post(path string, values url.Values, data interface{}) error {
+	return c.do("POST", path, values, data)
+}
+
+// do sends a request to the Vultr API, retrying with exponential backoff
+// when the response indicates the per-IP rate limit was hit.
+func (c *Client) do(method, path string, values url.Values, data interface{}) error {
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(rateLimitBackoff(attempt))
+		}
+
+		c.limiter.wait()
+
+		err := c.request(method, path, values, data)
+		if err == nil {
+			return nil
+		}
+		if !isRateLimitErr(err) {
+			return err
+		}
+		lastErr = err
+	}
+	return lastErr
+}
+
+func (c *Client) request(method, path string, values url.Values, data interface{}) error {
+	rel, err := url.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	body := strings.NewReader("")
+	if method == "POST" {
+		body = strings.NewReader(values.Encode())
+	}
+
+	req, err := http.NewRequest(method, c.Endpoint.ResolveReference(rel).String(), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("API-Key", c.APIKey)
+	req.Header.Add("User-Agent", c.UserAgent)
+	if method == "POST" {
+		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode == http.StatusServiceUnavailable ||
+			strings.Contains(string(respBody), "Rate limit") {
+			return fmt.Errorf("rate limit reached: %v %v: %v", method, path, strings.TrimSpace(string(respBody)))
+		}
+		return fmt.Errorf("%v %v: %v %v", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if data != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isRateLimitErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "rate limit reached")
+}
+
+func rateLimitBackoff(attempt int) time.Duration {
+	return time.Duration(1<<uint(attempt-1)) * 250 * time.Millisecond
+}
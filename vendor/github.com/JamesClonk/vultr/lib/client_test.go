@@ -0,0 +1,88 @@
+package lib
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestClientRetriesOnRateLimitThenGivesUp(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":"Rate limit reached"}`))
+	}))
+	defer server.Close()
+
+	c := testClient(t, server.URL)
+	c.SetMaxRetries(1)
+
+	if err := c.get(`server/list`, nil); err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 1 initial attempt + 1 retry, got %v attempts", attempts)
+	}
+}
+
+func TestClientSucceedsAfterTransientRateLimit(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"SUBID":"1"}`))
+	}))
+	defer server.Close()
+
+	c := testClient(t, server.URL)
+	c.SetMaxRetries(1)
+
+	var out map[string]string
+	if err := c.get(`server/list`, &out); err != nil {
+		t.Fatalf("expected retry to succeed, got error: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %v", attempts)
+	}
+}
+
+func TestClientDoesNotTreatOKResponseBodyAsRateLimited(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"label":"Rate limit exceeded last month"}`))
+	}))
+	defer server.Close()
+
+	c := testClient(t, server.URL)
+	c.SetMaxRetries(1)
+
+	var out map[string]string
+	if err := c.get(`server/list`, &out); err != nil {
+		t.Fatalf("expected a 200 response to decode regardless of its body, got error: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a single attempt since the response was not actually rate-limited, got %v", attempts)
+	}
+}
+
+func testClient(t *testing.T, rawURL string) *Client {
+	t.Helper()
+
+	endpoint, err := url.Parse(rawURL + "/")
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+
+	c := NewClient("test-api-key", &Options{Endpoint: endpoint.String()})
+	c.SetRateLimit(time.Millisecond, 10)
+	return c
+}
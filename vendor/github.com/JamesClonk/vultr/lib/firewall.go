@@ -0,0 +1,221 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// FirewallGroup on Vultr account
+type FirewallGroup struct {
+	ID            string `json:"FIREWALLGROUPID"`
+	Description   string `json:"description"`
+	DateCreated   string `json:"date_created"`
+	DateModified  string `json:"date_modified"`
+	InstanceCount int    `json:"instance_count"`
+	RuleCount     int    `json:"rule_count"`
+	MaxRuleCount  int    `json:"max_rule_count"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler on FirewallGroup. InstanceCount,
+// RuleCount and MaxRuleCount are plain ints above but decoded through
+// jsonNumber here, since the Vultr API is free to send any of them as a
+// JSON string instead of a JSON number.
+func (g *FirewallGroup) UnmarshalJSON(data []byte) error {
+	type alias FirewallGroup
+	aux := &struct {
+		InstanceCount jsonNumber `json:"instance_count"`
+		RuleCount     jsonNumber `json:"rule_count"`
+		MaxRuleCount  jsonNumber `json:"max_rule_count"`
+		*alias
+	}{
+		alias: (*alias)(g),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	g.InstanceCount = int(aux.InstanceCount)
+	g.RuleCount = int(aux.RuleCount)
+	g.MaxRuleCount = int(aux.MaxRuleCount)
+
+	return nil
+}
+
+// FirewallRule of a FirewallGroup
+type FirewallRule struct {
+	ID       int    `json:"rulenumber"`
+	Action   string `json:"action"`
+	Protocol string `json:"protocol"`
+	Port     string `json:"port"`
+	Network  string `json:"subnet"`
+	Size     int    `json:"subnet_size"`
+	Source   string `json:"source"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler on FirewallRule. ID (the rule
+// number) and Size (the subnet size) are decoded through jsonNumber, since
+// the Vultr API is free to send either one as a JSON string.
+func (r *FirewallRule) UnmarshalJSON(data []byte) error {
+	type alias FirewallRule
+	aux := &struct {
+		ID   jsonNumber `json:"rulenumber"`
+		Size jsonNumber `json:"subnet_size"`
+		*alias
+	}{
+		alias: (*alias)(r),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	r.ID = int(aux.ID)
+	r.Size = int(aux.Size)
+
+	return nil
+}
+
+// CreateFirewallGroup creates a new firewall group
+func (c *Client) CreateFirewallGroup(description string) (FirewallGroup, error) {
+	values := url.Values{
+		"description": {description},
+	}
+
+	var group FirewallGroup
+	if err := c.post(`firewall/group_create`, values, &group); err != nil {
+		return FirewallGroup{}, err
+	}
+	group.Description = description
+
+	return group, nil
+}
+
+// DeleteFirewallGroup deletes a firewall group
+func (c *Client) DeleteFirewallGroup(id string) error {
+	values := url.Values{
+		"FIREWALLGROUPID": {id},
+	}
+
+	if err := c.post(`firewall/group_delete`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListFirewallGroups lists all firewall groups on the current account
+func (c *Client) ListFirewallGroups() (groups []FirewallGroup, err error) {
+	var groupMap map[string]FirewallGroup
+	if err := c.get(`firewall/group_list`, &groupMap); err != nil {
+		return nil, err
+	}
+
+	for _, group := range groupMap {
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// GetFirewallGroup looks up a single firewall group by ID
+func (c *Client) GetFirewallGroup(id string) (FirewallGroup, error) {
+	groups, err := c.ListFirewallGroups()
+	if err != nil {
+		return FirewallGroup{}, err
+	}
+
+	for _, group := range groups {
+		if group.ID == id {
+			return group, nil
+		}
+	}
+	return FirewallGroup{}, fmt.Errorf("firewall group %v not found", id)
+}
+
+// SetFirewallGroupDescription updates the description of a firewall group
+func (c *Client) SetFirewallGroupDescription(id, description string) error {
+	values := url.Values{
+		"FIREWALLGROUPID": {id},
+		"description":     {description},
+	}
+
+	if err := c.post(`firewall/group_set_description`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateFirewallRule adds a new rule to a firewall group. ipType must be
+// "v4" or "v6".
+func (c *Client) CreateFirewallRule(groupID, ipType, protocol, port, network string, size int) (FirewallRule, error) {
+	values := url.Values{
+		"FIREWALLGROUPID": {groupID},
+		"direction":       {"in"},
+		"ip_type":         {ipType},
+		"protocol":        {protocol},
+		"subnet":          {network},
+		"subnet_size":     {fmt.Sprintf("%v", size)},
+	}
+
+	if port != "" {
+		values.Add("port", port)
+	}
+
+	var rule FirewallRule
+	if err := c.post(`firewall/rule_create`, values, &rule); err != nil {
+		return FirewallRule{}, err
+	}
+	rule.Protocol = protocol
+	rule.Port = port
+	rule.Network = network
+	rule.Size = size
+
+	return rule, nil
+}
+
+// DeleteFirewallRule removes a rule from a firewall group. ipType must be
+// "v4" or "v6".
+func (c *Client) DeleteFirewallRule(groupID, ipType string, ruleID int) error {
+	values := url.Values{
+		"FIREWALLGROUPID": {groupID},
+		"ip_type":         {ipType},
+		"rulenumber":      {fmt.Sprintf("%v", ruleID)},
+	}
+
+	if err := c.post(`firewall/rule_delete`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetFirewallRules lists the rules of a firewall group. ipType must be
+// "v4" or "v6".
+func (c *Client) GetFirewallRules(groupID, ipType string) (rules []FirewallRule, err error) {
+	var ruleMap map[string]FirewallRule
+	if err := c.get(`firewall/rule_list?FIREWALLGROUPID=`+groupID+`&direction=in&ip_type=`+ipType, &ruleMap); err != nil {
+		return nil, err
+	}
+
+	for _, rule := range ruleMap {
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// SetFirewallGroup attaches (or, with an empty groupID, detaches) a firewall
+// group on an existing server
+func (c *Client) SetFirewallGroup(serverID, groupID string) error {
+	if groupID == "" {
+		groupID = "0"
+	}
+
+	values := url.Values{
+		"SUBID":           {serverID},
+		"FIREWALLGROUPID": {groupID},
+	}
+
+	if err := c.post(`server/firewall_group_set`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// jsonNumber decodes a JSON field the Vultr API may encode as either a JSON
+// number or a JSON string into an int, so resources can declare the field
+// with its real type and a plain struct tag instead of hand-rolling a
+// fmt.Sprintf/strconv.Parse* dance in a custom UnmarshalJSON.
+type jsonNumber int
+
+func (n *jsonNumber) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		*n = 0
+	case float64:
+		*n = jsonNumber(int64(v))
+	case string:
+		if v == "" {
+			*n = 0
+			return nil
+		}
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*n = jsonNumber(i)
+	default:
+		return fmt.Errorf("jsonNumber: unsupported JSON type %T", raw)
+	}
+	return nil
+}
+
+// jsonFloat is jsonNumber for float64 fields, e.g. bandwidth and charges
+// that the Vultr API sometimes quotes and sometimes doesn't.
+type jsonFloat float64
+
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		*f = 0
+	case float64:
+		*f = jsonFloat(v)
+	case string:
+		if v == "" {
+			*f = 0
+			return nil
+		}
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		*f = jsonFloat(parsed)
+	default:
+		return fmt.Errorf("jsonFloat: unsupported JSON type %T", raw)
+	}
+	return nil
+}
+
+// jsonBool is jsonNumber for bool fields, accepting a JSON bool, a 0/1
+// JSON number, or a "yes"/"no"/"true"/"false" JSON string.
+type jsonBool bool
+
+func (b *jsonBool) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		*b = false
+	case bool:
+		*b = jsonBool(v)
+	case float64:
+		*b = v != 0
+	case string:
+		switch v {
+		case "", "0", "no", "false":
+			*b = false
+		default:
+			*b = true
+		}
+	default:
+		return fmt.Errorf("jsonBool: unsupported JSON type %T", raw)
+	}
+	return nil
+}
+
+// jsonString decodes a JSON field that the Vultr API documents as a string
+// but, like everything else in this API, may occasionally send as a number,
+// a bool or null, into a string. This mirrors the fmt.Sprintf("%v", ...)
+// coercion the old Server.UnmarshalJSON applied to every field regardless of
+// its declared type, so a field flipping type doesn't hard-fail decoding of
+// the whole response.
+type jsonString string
+
+func (s *jsonString) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case nil:
+		*s = ""
+	case string:
+		*s = jsonString(v)
+	case float64:
+		*s = jsonString(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		*s = jsonString(strconv.FormatBool(v))
+	default:
+		return fmt.Errorf("jsonString: unsupported JSON type %T", raw)
+	}
+	return nil
+}
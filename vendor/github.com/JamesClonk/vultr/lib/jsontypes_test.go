@@ -0,0 +1,112 @@
+package lib
+
+import "testing"
+
+func TestJsonNumberUnmarshal(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want jsonNumber
+	}{
+		{"number", `42`, 42},
+		{"quoted number", `"42"`, 42},
+		{"zero", `0`, 0},
+		{"quoted zero", `"0"`, 0},
+		{"empty string", `""`, 0},
+		{"null", `null`, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var n jsonNumber
+			if err := n.UnmarshalJSON([]byte(c.in)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if n != c.want {
+				t.Fatalf("got %v, want %v", n, c.want)
+			}
+		})
+	}
+}
+
+func TestJsonFloatUnmarshal(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want jsonFloat
+	}{
+		{"number", `3.14`, 3.14},
+		{"quoted float", `"3.14"`, 3.14},
+		{"zero", `0`, 0},
+		{"empty string", `""`, 0},
+		{"null", `null`, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var f jsonFloat
+			if err := f.UnmarshalJSON([]byte(c.in)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if f != c.want {
+				t.Fatalf("got %v, want %v", f, c.want)
+			}
+		})
+	}
+}
+
+func TestJsonBoolUnmarshal(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want jsonBool
+	}{
+		{"true", `true`, true},
+		{"false", `false`, false},
+		{"one", `1`, true},
+		{"zero", `0`, false},
+		{"yes", `"yes"`, true},
+		{"no", `"no"`, false},
+		{"empty string", `""`, false},
+		{"null", `null`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b jsonBool
+			if err := b.UnmarshalJSON([]byte(c.in)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if b != c.want {
+				t.Fatalf("got %v, want %v", b, c.want)
+			}
+		})
+	}
+}
+
+func TestJsonStringUnmarshal(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want jsonString
+	}{
+		{"string", `"hello"`, "hello"},
+		{"number", `42`, "42"},
+		{"float", `3.5`, "3.5"},
+		{"bool true", `true`, "true"},
+		{"bool false", `false`, "false"},
+		{"null", `null`, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var s jsonString
+			if err := s.UnmarshalJSON([]byte(c.in)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s != c.want {
+				t.Fatalf("got %q, want %q", s, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Plan on Vultr that a server can be created with or upgraded to
+type Plan struct {
+	ID        int     `json:"VPSPLANID"`
+	Name      string  `json:"name"`
+	VCpus     int     `json:"vcpu_count"`
+	RAM       int     `json:"ram"`
+	Disk      int     `json:"disk"`
+	Bandwidth float64 `json:"bandwidth"`
+	Price     string  `json:"price_per_month"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler on Plan. ID, VCpus, RAM, Disk
+// and Bandwidth can arrive as either a JSON number or a JSON string, so
+// they're decoded through jsonNumber/jsonFloat; Price is a currency string
+// like Server.Cost and gets the same jsonString treatment since it isn't
+// guaranteed to stay quoted either.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	type alias Plan
+	aux := &struct {
+		ID        jsonNumber `json:"VPSPLANID"`
+		VCpus     jsonNumber `json:"vcpu_count"`
+		RAM       jsonNumber `json:"ram"`
+		Disk      jsonNumber `json:"disk"`
+		Bandwidth jsonFloat  `json:"bandwidth"`
+		Price     jsonString `json:"price_per_month"`
+		*alias
+	}{
+		alias: (*alias)(p),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	p.ID = int(aux.ID)
+	p.VCpus = int(aux.VCpus)
+	p.RAM = int(aux.RAM)
+	p.Disk = int(aux.Disk)
+	p.Bandwidth = float64(aux.Bandwidth)
+	p.Price = string(aux.Price)
+
+	return nil
+}
+
+// Flavor describes the minimum RAM, vCPU count and disk size a caller wants
+// a plan to provide, without having to know Vultr's numeric PlanID for it.
+type Flavor struct {
+	RAM   int
+	VCpus int
+	Disk  int
+}
+
+// GetPlans lists all plans available on Vultr
+func (c *Client) GetPlans() (plans []Plan, err error) {
+	var planMap map[string]Plan
+	if err := c.get(`plans/list`, &planMap); err != nil {
+		return nil, err
+	}
+
+	for _, plan := range planMap {
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// UpgradePlan changes the PlanID of an existing server
+func (c *Client) UpgradePlan(id string, planID int) error {
+	values := url.Values{
+		"SUBID":     {id},
+		"VPSPLANID": {fmt.Sprintf("%v", planID)},
+	}
+
+	if err := c.post(`server/upgrade_plan`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListUpgradePlans lists the PlanIDs that a server can be upgraded to
+func (c *Client) ListUpgradePlans(id string) (planIDs []int, err error) {
+	var ids []jsonNumber
+	if err := c.get(`server/upgrade_plan_list?SUBID=`+id, &ids); err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		planIDs = append(planIDs, int(id))
+	}
+	return planIDs, nil
+}
+
+// GetMatchingFlavorID returns the PlanID of the smallest plan, by RAM,
+// available as an upgrade for the given server that meets or exceeds the
+// RAM, vCPU count and disk size requested in flavor. Among matching plans
+// with equal RAM, the first one in the upgrade plan list wins. It returns an
+// error if no upgrade plan matches.
+func (c *Client) GetMatchingFlavorID(id string, flavor Flavor) (int, error) {
+	planIDs, err := c.ListUpgradePlans(id)
+	if err != nil {
+		return 0, err
+	}
+
+	plans, err := c.GetPlans()
+	if err != nil {
+		return 0, err
+	}
+
+	byID := make(map[int]Plan)
+	for _, plan := range plans {
+		byID[plan.ID] = plan
+	}
+
+	match := Plan{}
+	found := false
+	for _, planID := range planIDs {
+		plan, ok := byID[planID]
+		if !ok {
+			continue
+		}
+		if plan.RAM < flavor.RAM || plan.VCpus < flavor.VCpus || plan.Disk < flavor.Disk {
+			continue
+		}
+		if !found || plan.RAM < match.RAM {
+			match = plan
+			found = true
+		}
+	}
+
+	if !found {
+		return 0, fmt.Errorf("no upgrade plan for server %v matches the requested flavor", id)
+	}
+	return match.ID, nil
+}
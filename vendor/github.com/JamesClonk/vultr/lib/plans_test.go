@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetMatchingFlavorIDReturnsErrorWhenNoPlanMatches(t *testing.T) {
+	server := newPlansTestServer(t, []int{201}, map[int]Plan{
+		201: {ID: 201, RAM: 1024, VCpus: 1, Disk: 25},
+	})
+	defer server.Close()
+
+	c := testClient(t, server.URL)
+
+	if _, err := c.GetMatchingFlavorID("100", Flavor{RAM: 4096, VCpus: 2, Disk: 50}); err == nil {
+		t.Fatal("expected an error when no upgrade plan matches the flavor")
+	}
+}
+
+func TestGetMatchingFlavorIDPicksSmallestMatch(t *testing.T) {
+	server := newPlansTestServer(t, []int{201, 202, 203}, map[int]Plan{
+		201: {ID: 201, RAM: 1024, VCpus: 1, Disk: 25},
+		202: {ID: 202, RAM: 4096, VCpus: 2, Disk: 60},
+		203: {ID: 203, RAM: 8192, VCpus: 4, Disk: 120},
+	})
+	defer server.Close()
+
+	c := testClient(t, server.URL)
+
+	id, err := c.GetMatchingFlavorID("100", Flavor{RAM: 4096, VCpus: 2, Disk: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 202 {
+		t.Fatalf("got plan %v, want 202 (the smallest plan meeting the flavor)", id)
+	}
+}
+
+// newPlansTestServer fakes the upgrade_plan_list and plans/list endpoints
+// that GetMatchingFlavorID stitches together.
+func newPlansTestServer(t *testing.T, upgradeIDs []int, plans map[int]Plan) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "upgrade_plan_list"):
+			json.NewEncoder(w).Encode(upgradeIDs)
+		case strings.Contains(r.URL.Path, "plans/list"):
+			planMap := make(map[string]Plan, len(plans))
+			for id, plan := range plans {
+				planMap[fmt.Sprintf("%v", id)] = plan
+			}
+			json.NewEncoder(w).Encode(planMap)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
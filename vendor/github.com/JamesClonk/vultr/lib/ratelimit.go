@@ -0,0 +1,56 @@
+package lib
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimiter is a simple token-bucket limiter used to keep requests to the
+// Vultr API within its per-second/per-IP rate limits. It is safe for
+// concurrent use so that multiple goroutines sharing a Client don't
+// stampede the API at once.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   time.Duration
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate time.Duration, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   rate,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks, if necessary, until a token is available. Tokens are
+// replenished continuously at one per rate, up to burst tokens banked.
+func (l *rateLimiter) wait() {
+	l.mu.Lock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() / l.rate.Seconds()
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		l.mu.Unlock()
+		return
+	}
+
+	sleep := time.Duration((1 - l.tokens) * float64(l.rate))
+	l.tokens = 0
+	l.last = l.last.Add(sleep)
+	l.mu.Unlock()
+
+	time.Sleep(sleep)
+}
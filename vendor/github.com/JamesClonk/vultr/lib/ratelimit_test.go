@@ -0,0 +1,48 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsBurstImmediately(t *testing.T) {
+	l := newRateLimiter(50*time.Millisecond, 2)
+
+	start := time.Now()
+	l.wait()
+	l.wait()
+	elapsed := time.Since(start)
+
+	if elapsed > 10*time.Millisecond {
+		t.Fatalf("expected burst tokens to be consumed without waiting, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterThrottlesPastBurst(t *testing.T) {
+	l := newRateLimiter(50*time.Millisecond, 1)
+
+	l.wait() // consumes the only token immediately
+
+	start := time.Now()
+	l.wait() // must wait for the next token to be replenished
+	elapsed := time.Since(start)
+
+	if elapsed < 40*time.Millisecond {
+		t.Fatalf("expected to wait close to the configured rate, only waited %v", elapsed)
+	}
+}
+
+func TestRateLimiterReplenishesOverTime(t *testing.T) {
+	l := newRateLimiter(20*time.Millisecond, 1)
+
+	l.wait()
+	time.Sleep(30 * time.Millisecond)
+
+	start := time.Now()
+	l.wait()
+	elapsed := time.Since(start)
+
+	if elapsed > 10*time.Millisecond {
+		t.Fatalf("expected a replenished token to be available without waiting, took %v", elapsed)
+	}
+}
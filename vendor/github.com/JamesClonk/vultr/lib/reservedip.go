@@ -0,0 +1,154 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ReservedIP on Vultr account
+type ReservedIP struct {
+	ID           string `json:"SUBID"`
+	RegionID     int    `json:"DCID"`
+	IPType       string `json:"ip_type"`
+	Subnet       string `json:"subnet"`
+	SubnetSize   int    `json:"subnet_size"`
+	Label        string `json:"label"`
+	AttachedToID string `json:"attached_SUBID"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler on ReservedIP. RegionID and
+// SubnetSize can arrive as either a JSON number or a JSON string, so they're
+// decoded through jsonNumber; SUBID and the attached server ID are
+// documented as strings but aren't guaranteed to stay that way, so they go
+// through jsonString too.
+func (r *ReservedIP) UnmarshalJSON(data []byte) error {
+	type alias ReservedIP
+	aux := &struct {
+		ID           jsonString `json:"SUBID"`
+		RegionID     jsonNumber `json:"DCID"`
+		SubnetSize   jsonNumber `json:"subnet_size"`
+		AttachedToID jsonString `json:"attached_SUBID"`
+		*alias
+	}{
+		alias: (*alias)(r),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	r.ID = string(aux.ID)
+	r.RegionID = int(aux.RegionID)
+	r.SubnetSize = int(aux.SubnetSize)
+	r.AttachedToID = string(aux.AttachedToID)
+
+	return nil
+}
+
+// CreateReservedIP creates a new reserved IP in a region. ipType must be
+// "v4" or "v6".
+func (c *Client) CreateReservedIP(regionID int, ipType, label string) (ReservedIP, error) {
+	values := url.Values{
+		"DCID":    {fmt.Sprintf("%v", regionID)},
+		"ip_type": {ipType},
+		"label":   {label},
+	}
+
+	var ip ReservedIP
+	if err := c.post(`reservedip/create`, values, &ip); err != nil {
+		return ReservedIP{}, err
+	}
+	ip.RegionID = regionID
+	ip.IPType = ipType
+	ip.Label = label
+
+	return ip, nil
+}
+
+// DestroyReservedIP removes a reserved IP from the current account
+func (c *Client) DestroyReservedIP(ip string) error {
+	values := url.Values{
+		"ip": {ip},
+	}
+
+	if err := c.post(`reservedip/destroy`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListReservedIP lists all reserved IPs on the current account
+func (c *Client) ListReservedIP() (ips []ReservedIP, err error) {
+	var ipMap map[string]ReservedIP
+	if err := c.get(`reservedip/list`, &ipMap); err != nil {
+		return nil, err
+	}
+
+	for _, ip := range ipMap {
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// GetReservedIP looks up a single reserved IP by its SUBID
+func (c *Client) GetReservedIP(id string) (ReservedIP, error) {
+	ips, err := c.ListReservedIP()
+	if err != nil {
+		return ReservedIP{}, err
+	}
+
+	for _, ip := range ips {
+		if ip.ID == id {
+			return ip, nil
+		}
+	}
+	return ReservedIP{}, fmt.Errorf("reserved IP %v not found", id)
+}
+
+// AttachReservedIP attaches a reserved IP to an existing server
+func (c *Client) AttachReservedIP(ip, serverID string) error {
+	values := url.Values{
+		"ip":           {ip},
+		"attach_SUBID": {serverID},
+	}
+
+	if err := c.post(`reservedip/attach`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DetachReservedIP detaches a reserved IP from its server
+func (c *Client) DetachReservedIP(ip, serverID string) error {
+	values := url.Values{
+		"ip":           {ip},
+		"detach_SUBID": {serverID},
+	}
+
+	if err := c.post(`reservedip/detach`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ConvertReservedIP converts an existing server's IP address into a
+// reserved IP
+func (c *Client) ConvertReservedIP(serverID, ip, label string) (ReservedIP, error) {
+	values := url.Values{
+		"SUBID": {serverID},
+		"ip":    {ip},
+	}
+
+	if label != "" {
+		values.Add("label", label)
+	}
+
+	var reservedIP ReservedIP
+	if err := c.post(`reservedip/convert`, values, &reservedIP); err != nil {
+		return ReservedIP{}, err
+	}
+	reservedIP.Label = label
+
+	return reservedIP, nil
+}
@@ -0,0 +1,25 @@
+package lib
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestReservedIPUnmarshalJSONHandlesNumericSUBID(t *testing.T) {
+	data := []byte(`{"SUBID": 5678, "DCID": 1, "ip_type": "v4", "subnet": "198.51.100.1", "subnet_size": "32", "attached_SUBID": 1234}`)
+
+	var r ReservedIP
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatalf("unexpected error decoding reserved IP with numeric SUBID: %v", err)
+	}
+
+	if r.ID != "5678" {
+		t.Errorf("ID = %q, want %q", r.ID, "5678")
+	}
+	if r.AttachedToID != "1234" {
+		t.Errorf("AttachedToID = %q, want %q", r.AttachedToID, "1234")
+	}
+	if r.SubnetSize != 32 {
+		t.Errorf("SubnetSize = %v, want 32", r.SubnetSize)
+	}
+}
@@ -0,0 +1,78 @@
+package lib
+
+import (
+	"net/url"
+)
+
+// ReverseDNSIPv6 entry for a server's IPv6 address
+type ReverseDNSIPv6 struct {
+	IP      string `json:"ip"`
+	Reverse string `json:"reverse"`
+}
+
+// ListIPv6ReverseDNS lists the reverse DNS entries of a server's IPv6
+// addresses
+func (c *Client) ListIPv6ReverseDNS(serverID string) (entries []ReverseDNSIPv6, err error) {
+	if err := c.get(`server/reverse_list_ipv6?SUBID=`+serverID, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// SetIPv6ReverseDNS sets the reverse DNS entry for one of a server's IPv6
+// addresses
+func (c *Client) SetIPv6ReverseDNS(serverID, ip, entry string) error {
+	values := url.Values{
+		"SUBID": {serverID},
+		"ip":    {ip},
+		"entry": {entry},
+	}
+
+	if err := c.post(`server/reverse_set_ipv6`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteIPv6ReverseDNS removes the reverse DNS entry for one of a server's
+// IPv6 addresses
+func (c *Client) DeleteIPv6ReverseDNS(serverID, ip string) error {
+	values := url.Values{
+		"SUBID": {serverID},
+		"ip":    {ip},
+	}
+
+	if err := c.post(`server/reverse_delete_ipv6`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetIPv4ReverseDNS sets the reverse DNS entry for one of a server's IPv4
+// addresses
+func (c *Client) SetIPv4ReverseDNS(serverID, ip, entry string) error {
+	values := url.Values{
+		"SUBID": {serverID},
+		"ip":    {ip},
+		"entry": {entry},
+	}
+
+	if err := c.post(`server/reverse_set_ipv4`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultIPv4ReverseDNS resets the reverse DNS entry for one of a server's
+// IPv4 addresses back to its default value
+func (c *Client) DefaultIPv4ReverseDNS(serverID, ip string) error {
+	values := url.Values{
+		"SUBID": {serverID},
+		"ip":    {ip},
+	}
+
+	if err := c.post(`server/reverse_default_ipv4`, values, nil); err != nil {
+		return err
+	}
+	return nil
+}
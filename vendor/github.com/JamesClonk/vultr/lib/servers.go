@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"strconv"
 )
 
 // Server (virtual machine) on Vultr account
@@ -16,26 +15,27 @@ type Server struct {
 	RAM              string      `json:"ram"`
 	Disk             string      `json:"disk"`
 	MainIP           string      `json:"main_ip"`
-	VCpus            int         `json:"vcpu_count,string"`
+	VCpus            int         `json:"vcpu_count"`
 	Location         string      `json:"location"`
-	RegionID         int         `json:"DCID,string"`
+	RegionID         int         `json:"DCID"`
 	DefaultPassword  string      `json:"default_password"`
 	Created          string      `json:"date_created"`
 	PendingCharges   float64     `json:"pending_charges"`
 	Status           string      `json:"status"`
 	Cost             string      `json:"cost_per_month"`
 	CurrentBandwidth float64     `json:"current_bandwidth_gb"`
-	AllowedBandwidth float64     `json:"allowed_bandwidth_gb,string"`
+	AllowedBandwidth float64     `json:"allowed_bandwidth_gb"`
 	NetmaskV4        string      `json:"netmask_v4"`
 	GatewayV4        string      `json:"gateway_v4"`
 	PowerStatus      string      `json:"power_status"`
 	ServerState      string      `json:"server_state"`
-	PlanID           int         `json:"VPSPLANID,string"`
+	PlanID           int         `json:"VPSPLANID"`
 	V6Networks       []V6Network `json:"v6_networks"`
 	InternalIP       string      `json:"internal_ip"`
 	KVMUrl           string      `json:"kvm_url"`
 	AutoBackups      string      `json:"auto_backups"`
 	Tag              string      `json:"tag"`
+	BlockStorage     []string    `json:"block_storage"`
 }
 
 // ServerOptions are optional parameters to be used during server creation
@@ -50,6 +50,7 @@ type ServerOptions struct {
 	PrivateNetworking    bool
 	AutoBackups          bool
 	DontNotifyOnActivate bool
+	FirewallGroupID      string
 }
 
 // V6Network represents a IPv6 network of a Vultr server
@@ -59,122 +60,113 @@ type V6Network struct {
 	NetworkSize string `json:"v6_network_size"`
 }
 
+// UnmarshalJSON implements json.Unmarshaler on V6Network, routing its fields
+// through jsonString since the Vultr API can send them as something other
+// than a JSON string.
+func (v *V6Network) UnmarshalJSON(data []byte) error {
+	type alias V6Network
+	aux := &struct {
+		Network     jsonString `json:"v6_network"`
+		MainIP      jsonString `json:"v6_main_ip"`
+		NetworkSize jsonString `json:"v6_network_size"`
+		*alias
+	}{
+		alias: (*alias)(v),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	v.Network = string(aux.Network)
+	v.MainIP = string(aux.MainIP)
+	v.NetworkSize = string(aux.NetworkSize)
+
+	return nil
+}
+
 // ISOStatus represents an ISO image attached to a Vultr server
 type ISOStatus struct {
 	State string `json:"state"`
 	ISOID string `json:"ISOID"`
 }
 
-// UnmarshalJSON implements json.Unmarshaller on Server.
+// UnmarshalJSON implements json.Unmarshaler on Server.
 // This is needed because the Vultr API is inconsistent in it's JSON responses for servers.
-// Some fields can change type, from JSON number to JSON string and vice-versa.
-func (s *Server) UnmarshalJSON(data []byte) (err error) {
-	if s == nil {
-		*s = Server{}
-	}
-
-	var fields map[string]interface{}
-	if err := json.Unmarshal(data, &fields); err != nil {
-		return err
-	}
-
-	value := fmt.Sprintf("%v", fields["vcpu_count"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	vcpu, err := strconv.ParseInt(value, 10, 64)
-	if err != nil {
-		return err
-	}
-	s.VCpus = int(vcpu)
-
-	value = fmt.Sprintf("%v", fields["DCID"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	region, err := strconv.ParseInt(value, 10, 64)
-	if err != nil {
+// Some fields can change type, from JSON number to JSON string and vice-versa. Numeric fields
+// are routed through jsonNumber/jsonFloat and every other field through jsonString, both of
+// which accept whatever type actually comes back instead of hard-failing the whole decode.
+func (s *Server) UnmarshalJSON(data []byte) error {
+	type alias Server
+	aux := &struct {
+		ID               jsonString   `json:"SUBID"`
+		Name             jsonString   `json:"label"`
+		OS               jsonString   `json:"os"`
+		RAM              jsonString   `json:"ram"`
+		Disk             jsonString   `json:"disk"`
+		MainIP           jsonString   `json:"main_ip"`
+		VCpus            jsonNumber   `json:"vcpu_count"`
+		Location         jsonString   `json:"location"`
+		RegionID         jsonNumber   `json:"DCID"`
+		DefaultPassword  jsonString   `json:"default_password"`
+		Created          jsonString   `json:"date_created"`
+		PendingCharges   jsonFloat    `json:"pending_charges"`
+		Status           jsonString   `json:"status"`
+		Cost             jsonString   `json:"cost_per_month"`
+		CurrentBandwidth jsonFloat    `json:"current_bandwidth_gb"`
+		AllowedBandwidth jsonFloat    `json:"allowed_bandwidth_gb"`
+		NetmaskV4        jsonString   `json:"netmask_v4"`
+		GatewayV4        jsonString   `json:"gateway_v4"`
+		PowerStatus      jsonString   `json:"power_status"`
+		ServerState      jsonString   `json:"server_state"`
+		PlanID           jsonNumber   `json:"VPSPLANID"`
+		InternalIP       jsonString   `json:"internal_ip"`
+		KVMUrl           jsonString   `json:"kvm_url"`
+		AutoBackups      jsonString   `json:"auto_backups"`
+		Tag              jsonString   `json:"tag"`
+		BlockStorage     []jsonString `json:"block_storage"`
+		*alias
+	}{
+		alias: (*alias)(s),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
 		return err
 	}
-	s.RegionID = int(region)
 
-	value = fmt.Sprintf("%v", fields["VPSPLANID"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	plan, err := strconv.ParseInt(value, 10, 64)
-	if err != nil {
-		return err
-	}
-	s.PlanID = int(plan)
+	s.ID = string(aux.ID)
+	s.Name = string(aux.Name)
+	s.OS = string(aux.OS)
+	s.RAM = string(aux.RAM)
+	s.Disk = string(aux.Disk)
+	s.MainIP = string(aux.MainIP)
+	s.VCpus = int(aux.VCpus)
+	s.Location = string(aux.Location)
+	s.RegionID = int(aux.RegionID)
+	s.DefaultPassword = string(aux.DefaultPassword)
+	s.Created = string(aux.Created)
+	s.PendingCharges = float64(aux.PendingCharges)
+	s.Status = string(aux.Status)
+	s.Cost = string(aux.Cost)
+	s.CurrentBandwidth = float64(aux.CurrentBandwidth)
+	s.AllowedBandwidth = float64(aux.AllowedBandwidth)
+	s.NetmaskV4 = string(aux.NetmaskV4)
+	s.GatewayV4 = string(aux.GatewayV4)
+	s.PowerStatus = string(aux.PowerStatus)
+	s.ServerState = string(aux.ServerState)
+	s.PlanID = int(aux.PlanID)
+	s.InternalIP = string(aux.InternalIP)
+	s.KVMUrl = string(aux.KVMUrl)
+	s.AutoBackups = string(aux.AutoBackups)
+	s.Tag = string(aux.Tag)
+
+	blockStorage := make([]string, len(aux.BlockStorage))
+	for i, volume := range aux.BlockStorage {
+		blockStorage[i] = string(volume)
+	}
+	s.BlockStorage = blockStorage
 
-	value = fmt.Sprintf("%v", fields["pending_charges"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	pc, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return err
-	}
-	s.PendingCharges = pc
-
-	value = fmt.Sprintf("%v", fields["current_bandwidth_gb"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	cb, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return err
-	}
-	s.CurrentBandwidth = cb
-
-	value = fmt.Sprintf("%v", fields["allowed_bandwidth_gb"])
-	if len(value) == 0 || value == "<nil>" {
-		value = "0"
-	}
-	ab, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return err
-	}
-	s.AllowedBandwidth = ab
-
-	s.ID = fmt.Sprintf("%v", fields["SUBID"])
-	s.Name = fmt.Sprintf("%v", fields["label"])
-	s.OS = fmt.Sprintf("%v", fields["os"])
-	s.RAM = fmt.Sprintf("%v", fields["ram"])
-	s.Disk = fmt.Sprintf("%v", fields["disk"])
-	s.MainIP = fmt.Sprintf("%v", fields["main_ip"])
-	s.Location = fmt.Sprintf("%v", fields["location"])
-	s.DefaultPassword = fmt.Sprintf("%v", fields["default_password"])
-	s.Created = fmt.Sprintf("%v", fields["date_created"])
-	s.Status = fmt.Sprintf("%v", fields["status"])
-	s.Cost = fmt.Sprintf("%v", fields["cost_per_month"])
-	s.NetmaskV4 = fmt.Sprintf("%v", fields["netmask_v4"])
-	s.GatewayV4 = fmt.Sprintf("%v", fields["gateway_v4"])
-	s.PowerStatus = fmt.Sprintf("%v", fields["power_status"])
-	s.ServerState = fmt.Sprintf("%v", fields["server_state"])
-
-	v6networks := make([]V6Network, 0)
-	if networks, ok := fields["v6_networks"].([]interface{}); ok {
-		for _, network := range networks {
-			if network, ok := network.(map[string]interface{}); ok {
-				v6network := V6Network{
-					Network:     fmt.Sprintf("%v", network["v6_network"]),
-					MainIP:      fmt.Sprintf("%v", network["v6_main_ip"]),
-					NetworkSize: fmt.Sprintf("%v", network["v6_network_size"]),
-				}
-				v6networks = append(v6networks, v6network)
-			}
-		}
-		s.V6Networks = v6networks
-	}
-
-	s.InternalIP = fmt.Sprintf("%v", fields["internal_ip"])
-	s.KVMUrl = fmt.Sprintf("%v", fields["kvm_url"])
-	s.AutoBackups = fmt.Sprintf("%v", fields["auto_backups"])
-	s.Tag = fmt.Sprintf("%v", fields["tag"])
-
-	return
+	return nil
 }
 
 func (c *Client) GetServers() (servers []Server, err error) {
@@ -241,6 +233,10 @@ func (c *Client) CreateServer(name string, regionID, planID, osID int, options *
 			values.Add("SSHKEYID", options.SSHKey)
 		}
 
+		if options.FirewallGroupID != "" {
+			values.Add("FIREWALLGROUPID", options.FirewallGroupID)
+		}
+
 		values.Add("enable_ipv6", "no")
 		if options.IPV6 {
 			values.Set("enable_ipv6", "yes")
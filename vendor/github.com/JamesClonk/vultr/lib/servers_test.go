@@ -0,0 +1,66 @@
+package lib
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestServerUnmarshalJSONHandlesTypeQuirks guards against Server's custom
+// UnmarshalJSON hard-failing (and dropping every server in a response) when
+// the Vultr API sends a field as a different JSON type than usual.
+func TestServerUnmarshalJSONHandlesTypeQuirks(t *testing.T) {
+	data := []byte(`{
+		"SUBID": 1234,
+		"label": "web-1",
+		"vcpu_count": "2",
+		"DCID": 1,
+		"VPSPLANID": "201",
+		"pending_charges": "1.5",
+		"current_bandwidth_gb": 0,
+		"allowed_bandwidth_gb": "1000",
+		"cost_per_month": 5,
+		"auto_backups": false,
+		"tag": null,
+		"v6_networks": [{"v6_network": "2001:db8::", "v6_main_ip": "2001:db8::1", "v6_network_size": 64}],
+		"block_storage": [12345, "67890"]
+	}`)
+
+	var s Server
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatalf("unexpected error decoding server with mixed JSON types: %v", err)
+	}
+
+	if s.ID != "1234" {
+		t.Errorf("ID = %q, want %q", s.ID, "1234")
+	}
+	if s.VCpus != 2 {
+		t.Errorf("VCpus = %v, want 2", s.VCpus)
+	}
+	if s.RegionID != 1 {
+		t.Errorf("RegionID = %v, want 1", s.RegionID)
+	}
+	if s.PlanID != 201 {
+		t.Errorf("PlanID = %v, want 201", s.PlanID)
+	}
+	if s.PendingCharges != 1.5 {
+		t.Errorf("PendingCharges = %v, want 1.5", s.PendingCharges)
+	}
+	if s.AllowedBandwidth != 1000 {
+		t.Errorf("AllowedBandwidth = %v, want 1000", s.AllowedBandwidth)
+	}
+	if s.Cost != "5" {
+		t.Errorf("Cost = %q, want %q", s.Cost, "5")
+	}
+	if s.AutoBackups != "false" {
+		t.Errorf("AutoBackups = %q, want %q", s.AutoBackups, "false")
+	}
+	if s.Tag != "" {
+		t.Errorf("Tag = %q, want empty string", s.Tag)
+	}
+	if len(s.V6Networks) != 1 || s.V6Networks[0].NetworkSize != "64" {
+		t.Errorf("V6Networks = %+v, want NetworkSize %q", s.V6Networks, "64")
+	}
+	if len(s.BlockStorage) != 2 || s.BlockStorage[0] != "12345" || s.BlockStorage[1] != "67890" {
+		t.Errorf("BlockStorage = %v, want [12345 67890]", s.BlockStorage)
+	}
+}